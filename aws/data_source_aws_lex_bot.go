@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexBotRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detect_sentiment": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"enable_model_improvements": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nlu_intent_confidence_threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexVersionLatest,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(name),
+		VersionOrAlias: aws.String(d.Get("version").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting bot %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", name),
+	}.String())
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("child_directed", resp.ChildDirected)
+	d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("detect_sentiment", resp.DetectSentiment)
+	d.Set("enable_model_improvements", resp.EnableModelImprovements)
+	d.Set("failure_reason", resp.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", resp.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", aws.TimeValue(resp.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", resp.Locale)
+	d.Set("name", resp.Name)
+	d.Set("nlu_intent_confidence_threshold", resp.NluIntentConfidenceThreshold)
+	d.Set("status", resp.Status)
+	d.Set("version", resp.Version)
+	d.Set("voice_id", resp.VoiceId)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexBotAlias() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexBotAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conversation_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     lexConversationLogsResource,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	botName := d.Get("bot_name").(string)
+	name := d.Get("name").(string)
+
+	resp, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+		BotName: aws.String(botName),
+		Name:    aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting bot alias %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s:%s", botName, name),
+	}.String())
+
+	d.Set("bot_name", resp.BotName)
+	d.Set("bot_version", resp.BotVersion)
+	d.Set("checksum", resp.Checksum)
+	d.Set("conversation_logs", flattenLexConversationLogs(resp.ConversationLogs))
+	d.Set("created_date", aws.TimeValue(resp.CreatedDate).Format(time.RFC3339))
+	d.Set("description", resp.Description)
+	d.Set("last_updated_date", aws.TimeValue(resp.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", resp.Name)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// lexTagsSchema returns the standard tags map schema shared by the Lex v1 resources that expose
+// TagResource/UntagResource/ListTagsForResource on their ARN (bots, bot aliases, intents, and
+// slot types).
+func lexTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// setLexTags reconciles the tags on a Lex resource ARN, diffing the old and new tag maps and
+// issuing TagResource/UntagResource calls for only what changed.
+func setLexTags(conn *lexmodelbuildingservice.LexModelBuildingService, arn string, oldTags, newTags map[string]interface{}) error {
+	create, remove := diffLexTags(oldTags, newTags)
+
+	if len(remove) > 0 {
+		if _, err := conn.UntagResource(&lexmodelbuildingservice.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     remove,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(create) > 0 {
+		if _, err := conn.TagResource(&lexmodelbuildingservice.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        create,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diffLexTags(oldTags, newTags map[string]interface{}) (create []*lexmodelbuildingservice.Tag, remove []*string) {
+	for k, v := range newTags {
+		old, ok := oldTags[k]
+		if !ok || old.(string) != v.(string) {
+			create = append(create, &lexmodelbuildingservice.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			remove = append(remove, aws.String(k))
+		}
+	}
+
+	return
+}
+
+func flattenLexTags(tags []*lexmodelbuildingservice.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+// setLexV2Tags mirrors setLexTags for the Lex Models V2 TagResource/UntagResource API, which
+// represents tags as a map[string]*string instead of v1's []*lexmodelbuildingservice.Tag.
+func setLexV2Tags(conn *lexmodelsv2.LexModelsV2, arn string, oldTags, newTags map[string]interface{}) error {
+	create, remove := diffLexV2Tags(oldTags, newTags)
+
+	if len(remove) > 0 {
+		if _, err := conn.UntagResource(&lexmodelsv2.UntagResourceInput{
+			ResourceARN: aws.String(arn),
+			TagKeys:     remove,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(create) > 0 {
+		if _, err := conn.TagResource(&lexmodelsv2.TagResourceInput{
+			ResourceARN: aws.String(arn),
+			Tags:        create,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diffLexV2Tags(oldTags, newTags map[string]interface{}) (create map[string]*string, remove []*string) {
+	create = make(map[string]*string)
+
+	for k, v := range newTags {
+		old, ok := oldTags[k]
+		if !ok || old.(string) != v.(string) {
+			create[k] = aws.String(v.(string))
+		}
+	}
+
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			remove = append(remove, aws.String(k))
+		}
+	}
+
+	return
+}
+
+func flattenLexV2Tags(tags map[string]*string) map[string]string {
+	m := make(map[string]string, len(tags))
+	for k, v := range tags {
+		m[k] = aws.StringValue(v)
+	}
+
+	return m
+}
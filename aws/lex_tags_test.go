@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+)
+
+func TestDiffLexTags(t *testing.T) {
+	oldTags := map[string]interface{}{
+		"keep":   "same",
+		"remove": "gone",
+		"change": "old-value",
+	}
+	newTags := map[string]interface{}{
+		"keep":   "same",
+		"change": "new-value",
+		"add":    "new",
+	}
+
+	create, remove := diffLexTags(oldTags, newTags)
+
+	gotCreate := map[string]string{}
+	for _, tag := range create {
+		gotCreate[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	wantCreate := map[string]string{
+		"change": "new-value",
+		"add":    "new",
+	}
+	if !reflect.DeepEqual(gotCreate, wantCreate) {
+		t.Errorf("create = %v, want %v", gotCreate, wantCreate)
+	}
+
+	gotRemove := []string{}
+	for _, key := range remove {
+		gotRemove = append(gotRemove, aws.StringValue(key))
+	}
+	sort.Strings(gotRemove)
+	wantRemove := []string{"remove"}
+	if !reflect.DeepEqual(gotRemove, wantRemove) {
+		t.Errorf("remove = %v, want %v", gotRemove, wantRemove)
+	}
+}
+
+func TestFlattenLexTags(t *testing.T) {
+	tags := []*lexmodelbuildingservice.Tag{
+		{Key: aws.String("foo"), Value: aws.String("bar")},
+	}
+
+	got := flattenLexTags(tags)
+	want := map[string]string{"foo": "bar"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenLexTags() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexV2TestBotAliasID is the reserved alias ID Lex Models V2 assigns to the "TestBotAlias" it
+// auto-creates alongside every bot. test_bot_alias_tags tags that alias, not the bot itself, so
+// it needs its own ARN distinct from lexV2BotArn.
+const lexV2TestBotAliasID = "TSTALIASID"
+
+// lexV2BotArn derives the ARN a Lex V2 bot is tagged under: account ID + region + bot/{botId}.
+func lexV2BotArn(meta interface{}, botID string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot/%s", botID),
+	}.String()
+}
+
+// lexV2TestBotAliasArn derives the ARN of a Lex V2 bot's auto-created test bot alias.
+func lexV2TestBotAliasArn(meta interface{}, botID string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot-alias/%s/%s", botID, lexV2TestBotAliasID),
+	}.String()
+}
+
+// Shared schema/expand/flatten helpers for the Lex Models V2 (lexmodelsv2) resource family.
+// These mirror the expand/flatten helpers in resource_aws_lex.go, but target the v2 API's
+// shapes (botId/botVersion/localeId addressing, data privacy, voice settings, and
+// conversation log settings).
+
+var lexV2DataPrivacyResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"child_directed": {
+			Type:     schema.TypeBool,
+			Required: true,
+		},
+	},
+}
+
+func expandLexV2DataPrivacy(m map[string]interface{}) *lexmodelsv2.DataPrivacy {
+	return &lexmodelsv2.DataPrivacy{
+		ChildDirected: aws.Bool(m["child_directed"].(bool)),
+	}
+}
+
+func flattenLexV2DataPrivacy(dataPrivacy *lexmodelsv2.DataPrivacy) []map[string]interface{} {
+	if dataPrivacy == nil {
+		return []map[string]interface{}{}
+	}
+
+	return flattenLexObject(map[string]interface{}{
+		"child_directed": aws.BoolValue(dataPrivacy.ChildDirected),
+	})
+}
+
+var lexV2VoiceSettingsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"engine": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  lexmodelsv2.VoiceEngineStandard,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelsv2.VoiceEngineStandard,
+				lexmodelsv2.VoiceEngineNeural,
+			}, false),
+		},
+		"voice_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+func expandLexV2VoiceSettings(m map[string]interface{}) *lexmodelsv2.VoiceSettings {
+	voiceSettings := &lexmodelsv2.VoiceSettings{
+		VoiceId: aws.String(m["voice_id"].(string)),
+	}
+
+	if v, ok := m["engine"]; ok && v != "" {
+		voiceSettings.Engine = aws.String(v.(string))
+	}
+
+	return voiceSettings
+}
+
+func flattenLexV2VoiceSettings(voiceSettings *lexmodelsv2.VoiceSettings) []map[string]interface{} {
+	if voiceSettings == nil {
+		return []map[string]interface{}{}
+	}
+
+	return flattenLexObject(map[string]interface{}{
+		"engine":   aws.StringValue(voiceSettings.Engine),
+		"voice_id": aws.StringValue(voiceSettings.VoiceId),
+	})
+}
+
+var lexV2TextLogDestinationResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"cloudwatch_log_group_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"log_prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var lexV2AudioLogDestinationResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"kms_key_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateArn,
+		},
+		"log_prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"s3_bucket_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+var lexV2ConversationLogSettingsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"audio_log": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"destination": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem:     lexV2AudioLogDestinationResource,
+					},
+				},
+			},
+		},
+		"text_log": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"destination": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem:     lexV2TextLogDestinationResource,
+					},
+				},
+			},
+		},
+	},
+}
+
+func expandLexV2ConversationLogSettings(m map[string]interface{}) *lexmodelsv2.ConversationLogSettings {
+	settings := &lexmodelsv2.ConversationLogSettings{}
+
+	for _, rawAudioLog := range m["audio_log"].([]interface{}) {
+		audioLog := rawAudioLog.(map[string]interface{})
+		destination := expandLexObject(audioLog["destination"])
+
+		settings.AudioLogSettings = append(settings.AudioLogSettings, &lexmodelsv2.AudioLogSetting{
+			Enabled: aws.Bool(audioLog["enabled"].(bool)),
+			Destination: &lexmodelsv2.AudioLogDestination{
+				S3Bucket: &lexmodelsv2.S3BucketLogDestination{
+					KmsKeyArn:   aws.String(destination["kms_key_arn"].(string)),
+					LogPrefix:   aws.String(destination["log_prefix"].(string)),
+					S3BucketArn: aws.String(destination["s3_bucket_arn"].(string)),
+				},
+			},
+		})
+	}
+
+	for _, rawTextLog := range m["text_log"].([]interface{}) {
+		textLog := rawTextLog.(map[string]interface{})
+		destination := expandLexObject(textLog["destination"])
+
+		settings.TextLogSettings = append(settings.TextLogSettings, &lexmodelsv2.TextLogSetting{
+			Enabled: aws.Bool(textLog["enabled"].(bool)),
+			Destination: &lexmodelsv2.TextLogDestination{
+				CloudWatch: &lexmodelsv2.CloudWatchLogGroupLogDestination{
+					CloudWatchLogGroupArn: aws.String(destination["cloudwatch_log_group_arn"].(string)),
+					LogPrefix:             aws.String(destination["log_prefix"].(string)),
+				},
+			},
+		})
+	}
+
+	return settings
+}
+
+func flattenLexV2ConversationLogSettings(settings *lexmodelsv2.ConversationLogSettings) []map[string]interface{} {
+	if settings == nil {
+		return []map[string]interface{}{}
+	}
+
+	flattened := map[string]interface{}{}
+
+	var audioLogs []map[string]interface{}
+	for _, audioLog := range settings.AudioLogSettings {
+		audioLogs = append(audioLogs, map[string]interface{}{
+			"enabled": aws.BoolValue(audioLog.Enabled),
+			"destination": flattenLexObject(map[string]interface{}{
+				"kms_key_arn":   aws.StringValue(audioLog.Destination.S3Bucket.KmsKeyArn),
+				"log_prefix":    aws.StringValue(audioLog.Destination.S3Bucket.LogPrefix),
+				"s3_bucket_arn": aws.StringValue(audioLog.Destination.S3Bucket.S3BucketArn),
+			}),
+		})
+	}
+	flattened["audio_log"] = audioLogs
+
+	var textLogs []map[string]interface{}
+	for _, textLog := range settings.TextLogSettings {
+		textLogs = append(textLogs, map[string]interface{}{
+			"enabled": aws.BoolValue(textLog.Enabled),
+			"destination": flattenLexObject(map[string]interface{}{
+				"cloudwatch_log_group_arn": aws.StringValue(textLog.Destination.CloudWatch.CloudWatchLogGroupArn),
+				"log_prefix":               aws.StringValue(textLog.Destination.CloudWatch.LogPrefix),
+			}),
+		})
+	}
+	flattened["text_log"] = textLogs
+
+	return flattenLexObject(flattened)
+}
+
+var lexV2BotAliasLocaleSettingsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"locale_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Required: true,
+		},
+		"code_hook_lambda_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+func expandLexV2BotAliasLocaleSettings(rawValues []map[string]interface{}) map[string]*lexmodelsv2.BotAliasLocaleSettings {
+	settings := make(map[string]*lexmodelsv2.BotAliasLocaleSettings, len(rawValues))
+
+	for _, rawValue := range rawValues {
+		localeSettings := &lexmodelsv2.BotAliasLocaleSettings{
+			Enabled: aws.Bool(rawValue["enabled"].(bool)),
+		}
+
+		if v, ok := rawValue["code_hook_lambda_arn"]; ok && v != "" {
+			localeSettings.CodeHookSpecification = &lexmodelsv2.CodeHookSpecification{
+				LambdaCodeHook: &lexmodelsv2.LambdaCodeHook{
+					CodeHookInterfaceVersion: aws.String("1.0"),
+					LambdaARN:                aws.String(v.(string)),
+				},
+			}
+		}
+
+		settings[rawValue["locale_id"].(string)] = localeSettings
+	}
+
+	return settings
+}
+
+func flattenLexV2BotAliasLocaleSettings(settings map[string]*lexmodelsv2.BotAliasLocaleSettings) (flattened []map[string]interface{}) {
+	for localeID, localeSettings := range settings {
+		flat := map[string]interface{}{
+			"locale_id": localeID,
+			"enabled":   aws.BoolValue(localeSettings.Enabled),
+		}
+
+		if localeSettings.CodeHookSpecification != nil && localeSettings.CodeHookSpecification.LambdaCodeHook != nil {
+			flat["code_hook_lambda_arn"] = aws.StringValue(localeSettings.CodeHookSpecification.LambdaCodeHook.LambdaARN)
+		}
+
+		flattened = append(flattened, flat)
+	}
+
+	return
+}
+
+// waitLexV2BotLocaleBuilt polls DescribeBotLocale until the locale reaches the Built status,
+// mirroring the GetBot polling resourceAwsLexBotCreate/Update already does for v1 bots.
+func waitLexV2BotLocaleBuilt(conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			lexmodelsv2.BotLocaleStatusBuilding,
+			lexmodelsv2.BotLocaleStatusProcessing,
+			lexmodelsv2.BotLocaleStatusCreating,
+			lexmodelsv2.BotLocaleStatusNotBuilt,
+		},
+		Target: []string{
+			lexmodelsv2.BotLocaleStatusBuilt,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeBotLocale(&lexmodelsv2.DescribeBotLocaleInput{
+				BotId:      aws.String(botID),
+				BotVersion: aws.String(botVersion),
+				LocaleId:   aws.String(localeID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			if aws.StringValue(resp.BotLocaleStatus) == lexmodelsv2.BotLocaleStatusFailed {
+				return resp, lexmodelsv2.BotLocaleStatusFailed, fmt.Errorf("bot locale %s/%s/%s failed to build: %s", botID, botVersion, localeID, aws.StringValueSlice(resp.FailureReasons))
+			}
+
+			return resp, aws.StringValue(resp.BotLocaleStatus), nil
+		},
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestExpandFlattenLexV2DataPrivacy(t *testing.T) {
+	dataPrivacy := expandLexV2DataPrivacy(map[string]interface{}{
+		"child_directed": true,
+	})
+
+	if !aws.BoolValue(dataPrivacy.ChildDirected) {
+		t.Fatal("expected ChildDirected to be true")
+	}
+
+	got := flattenLexV2DataPrivacy(dataPrivacy)
+	want := []map[string]interface{}{
+		{"child_directed": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenLexV2DataPrivacy() = %v, want %v", got, want)
+	}
+}
+
+func TestExpandFlattenLexV2VoiceSettings(t *testing.T) {
+	voiceSettings := expandLexV2VoiceSettings(map[string]interface{}{
+		"engine":   "neural",
+		"voice_id": "Joanna",
+	})
+
+	if aws.StringValue(voiceSettings.VoiceId) != "Joanna" {
+		t.Errorf("VoiceId = %s, want Joanna", aws.StringValue(voiceSettings.VoiceId))
+	}
+
+	got := flattenLexV2VoiceSettings(voiceSettings)
+	want := []map[string]interface{}{
+		{"engine": "neural", "voice_id": "Joanna"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenLexV2VoiceSettings() = %v, want %v", got, want)
+	}
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -179,6 +181,140 @@ func expandLexPrompt(m map[string]interface{}) (prompt *lexmodelbuildingservice.
 	return
 }
 
+var lexLogSettingsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"destination": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.DestinationCloudwatchLogs,
+				lexmodelbuildingservice.DestinationS3,
+			}, false),
+		},
+		"kms_key_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateArn,
+		},
+		"log_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.LogTypeAudio,
+				lexmodelbuildingservice.LogTypeText,
+			}, false),
+		},
+		"resource_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"resource_prefix": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	},
+}
+
+var lexConversationLogsResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"iam_role_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"log_settings": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 2,
+			Elem:     lexLogSettingsResource,
+		},
+	},
+}
+
+func flattenLexLogSettings(logSettings []*lexmodelbuildingservice.LogSettingsResponse) (flattenedLogSettings []map[string]interface{}) {
+	for _, logSetting := range logSettings {
+		flattenedLogSettings = append(flattenedLogSettings, map[string]interface{}{
+			"destination":     aws.StringValue(logSetting.Destination),
+			"kms_key_arn":     aws.StringValue(logSetting.KmsKeyArn),
+			"log_type":        aws.StringValue(logSetting.LogType),
+			"resource_arn":    aws.StringValue(logSetting.ResourceArn),
+			"resource_prefix": aws.StringValue(logSetting.ResourcePrefix),
+		})
+	}
+
+	return
+}
+
+// Expects a slice of maps representing the Lex objects.
+// The value passed into this function should have been run through the expandLexSet function.
+// Example: []map[destination: S3 kms_key_arn: arn:aws:kms:... log_type: AUDIO resource_arn: arn:aws:s3:::bucket]
+func expandLexLogSettings(rawValues []map[string]interface{}) (logSettings []*lexmodelbuildingservice.LogSettingsRequest) {
+	for _, rawValue := range rawValues {
+		logSetting := &lexmodelbuildingservice.LogSettingsRequest{
+			Destination: aws.String(rawValue["destination"].(string)),
+			LogType:     aws.String(rawValue["log_type"].(string)),
+			ResourceArn: aws.String(rawValue["resource_arn"].(string)),
+		}
+
+		if v, ok := rawValue["kms_key_arn"]; ok && v != "" {
+			logSetting.KmsKeyArn = aws.String(v.(string))
+		}
+
+		logSettings = append(logSettings, logSetting)
+	}
+
+	return
+}
+
+func flattenLexConversationLogs(conversationLogs *lexmodelbuildingservice.ConversationLogsResponse) []map[string]interface{} {
+	if conversationLogs == nil {
+		return []map[string]interface{}{}
+	}
+
+	flattened := map[string]interface{}{
+		"iam_role_arn": aws.StringValue(conversationLogs.IamRoleArn),
+		"log_settings": flattenLexLogSettings(conversationLogs.LogSettings),
+	}
+
+	return flattenLexObject(flattened)
+}
+
+func expandLexConversationLogs(m map[string]interface{}) *lexmodelbuildingservice.ConversationLogsRequest {
+	return &lexmodelbuildingservice.ConversationLogsRequest{
+		IamRoleArn:  aws.String(m["iam_role_arn"].(string)),
+		LogSettings: expandLexLogSettings(expandLexSet(m["log_settings"].(*schema.Set))),
+	}
+}
+
+// validateLexConversationLogsLogSettings enforces the same constraints the PutBotAlias API
+// itself applies, so plans fail fast instead of waiting on the API to reject the apply:
+//   - an S3 destination carrying AUDIO logs must be paired with a KMS key
+//   - AUDIO logs may only be paired with an S3 destination, never CLOUDWATCH_LOGS
+//   - a CLOUDWATCH_LOGS destination must not carry a KMS key
+func validateLexConversationLogsLogSettings(rawValues []map[string]interface{}) error {
+	for _, rawValue := range rawValues {
+		destination, _ := rawValue["destination"].(string)
+		logType, _ := rawValue["log_type"].(string)
+		kmsKeyArn, _ := rawValue["kms_key_arn"].(string)
+
+		if logType == lexmodelbuildingservice.LogTypeAudio && destination != lexmodelbuildingservice.DestinationS3 {
+			return fmt.Errorf("log_settings destination must be %s when log_type is %s", lexmodelbuildingservice.DestinationS3, lexmodelbuildingservice.LogTypeAudio)
+		}
+
+		if destination == lexmodelbuildingservice.DestinationS3 && logType == lexmodelbuildingservice.LogTypeAudio && kmsKeyArn == "" {
+			return fmt.Errorf("kms_key_arn is required when log_settings destination is %s and log_type is %s", lexmodelbuildingservice.DestinationS3, lexmodelbuildingservice.LogTypeAudio)
+		}
+
+		if destination == lexmodelbuildingservice.DestinationCloudwatchLogs && kmsKeyArn != "" {
+			return fmt.Errorf("kms_key_arn must not be set when log_settings destination is %s", lexmodelbuildingservice.DestinationCloudwatchLogs)
+		}
+	}
+
+	return nil
+}
+
 func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) (flattenedIntents []map[string]interface{}) {
 	for _, intent := range intents {
 		flattenedIntents = append(flattenedIntents, map[string]interface{}{
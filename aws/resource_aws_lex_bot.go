@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,190 +17,10 @@ import (
 // Many of the Lex resources require complex nested objects. Terraform maps only support simple key
 // value pairs and not complex or mixed types. That is why these resources are defined using the
 // schema.TypeList and a max of 1 item instead of the schema.TypeMap.
-
-var lexMessageResource = &schema.Resource{
-	Schema: map[string]*schema.Schema{
-		"content": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringLenBetween(1, 1000),
-		},
-		"content_type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				lexmodelbuildingservice.ContentTypeCustomPayload,
-				lexmodelbuildingservice.ContentTypePlainText,
-				lexmodelbuildingservice.ContentTypeSsml,
-			}, false),
-		},
-		"group_number": {
-			Type:         schema.TypeInt,
-			Optional:     true,
-			ValidateFunc: validation.IntBetween(1, 5),
-		},
-	},
-}
-
-func flattenLexMessages(messages []*lexmodelbuildingservice.Message) (flattenedMessages []map[string]interface{}) {
-	for _, message := range messages {
-		flattenedMessages = append(flattenedMessages, map[string]interface{}{
-			"content":      aws.StringValue(message.Content),
-			"content_type": aws.StringValue(message.ContentType),
-			"group_number": aws.Int64Value(message.GroupNumber),
-		})
-	}
-
-	return
-}
-
-// Expects a slice of maps representing the Lex objects.
-// The value passed into this function should have been run through the expandLexSet function.
-// Example: []map[content: test content_type: PlainText group_number: 1]
-func expandLexMessages(rawValues []interface{}) []*lexmodelbuildingservice.Message {
-	messages := make([]*lexmodelbuildingservice.Message, 0, len(rawValues))
-
-	for _, rawValue := range rawValues {
-		value, ok := rawValue.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		message := &lexmodelbuildingservice.Message{
-			Content:     aws.String(value["content"].(string)),
-			ContentType: aws.String(value["content_type"].(string)),
-		}
-
-		if v, ok := value["group_number"]; ok && v != 0 {
-			message.GroupNumber = aws.Int64(int64(v.(int)))
-		}
-
-		messages = append(messages, message)
-	}
-
-	return messages
-}
-
-var lexStatementResource = &schema.Resource{
-	Schema: map[string]*schema.Schema{
-		"message": {
-			Type:     schema.TypeSet,
-			Required: true,
-			MinItems: 1,
-			MaxItems: 15,
-			Elem:     lexMessageResource,
-		},
-		"response_card": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringLenBetween(1, 50000),
-		},
-	},
-}
-
-func flattenLexStatement(statement *lexmodelbuildingservice.Statement) (flattened map[string]interface{}) {
-	flattened = map[string]interface{}{}
-	flattened["message"] = flattenLexMessages(statement.Messages)
-
-	if statement.ResponseCard != nil {
-		flattened["response_card"] = aws.StringValue(statement.ResponseCard)
-	}
-
-	return
-}
-
-func expandLexStatement(rawObject interface{}) (statement *lexmodelbuildingservice.Statement) {
-	m := rawObject.([]interface{})[0].(map[string]interface{})
-
-	statement = &lexmodelbuildingservice.Statement{}
-	statement.Messages = expandLexMessages(m["message"].(*schema.Set).List())
-
-	if v, ok := m["response_card"]; ok && v != "" {
-		statement.ResponseCard = aws.String(v.(string))
-	}
-
-	return
-}
-
-var lexPromptResource = &schema.Resource{
-	Schema: map[string]*schema.Schema{
-		"max_attempts": {
-			Type:         schema.TypeInt,
-			Required:     true,
-			ValidateFunc: validation.IntBetween(1, 5),
-		},
-		"message": {
-			Type:     schema.TypeSet,
-			Required: true,
-			MinItems: 1,
-			MaxItems: 15,
-			Elem:     lexMessageResource,
-		},
-		"response_card": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringLenBetween(1, 50000),
-		},
-	},
-}
-
-func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) (flattened map[string]interface{}) {
-	flattened = map[string]interface{}{}
-	flattened["max_attempts"] = aws.Int64Value(prompt.MaxAttempts)
-	flattened["message"] = flattenLexMessages(prompt.Messages)
-
-	if prompt.ResponseCard != nil {
-		flattened["response_card"] = aws.StringValue(prompt.ResponseCard)
-	}
-
-	return
-}
-
-func expandLexPrompt(rawObject interface{}) (prompt *lexmodelbuildingservice.Prompt) {
-	m := rawObject.([]interface{})[0].(map[string]interface{})
-
-	prompt = &lexmodelbuildingservice.Prompt{}
-	prompt.MaxAttempts = aws.Int64(int64(m["max_attempts"].(int)))
-	prompt.Messages = expandLexMessages(m["message"].(*schema.Set).List())
-
-	if v, ok := m["response_card"]; ok && v != "" {
-		prompt.ResponseCard = aws.String(v.(string))
-	}
-
-	return
-}
-
-func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) (flattenedIntents []map[string]interface{}) {
-	for _, intent := range intents {
-		flattenedIntents = append(flattenedIntents, map[string]interface{}{
-			"intent_name":    aws.StringValue(intent.IntentName),
-			"intent_version": aws.StringValue(intent.IntentVersion),
-		})
-	}
-
-	return
-}
-
-// Expects a slice of maps representing the Lex objects.
-// The value passed into this function should have been run through the expandLexSet function.
-// Example: []map[intent_name: OrderFlowers intent_version: $LATEST]
-func expandLexIntents(rawValues []interface{}) []*lexmodelbuildingservice.Intent {
-	intents := make([]*lexmodelbuildingservice.Intent, 0, len(rawValues))
-
-	for _, rawValue := range rawValues {
-		value, ok := rawValue.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		intents = append(intents, &lexmodelbuildingservice.Intent{
-			IntentName:    aws.String(value["intent_name"].(string)),
-			IntentVersion: aws.String(value["intent_version"].(string)),
-		})
-	}
-
-	return intents
-}
+//
+// lexMessageResource, lexStatementResource, lexPromptResource and their expand/flatten helpers,
+// along with flattenLexIntents/expandLexIntents, live in resource_aws_lex.go so that this file and
+// resource_aws_lex_intent.go/resource_aws_lex_slot_type.go share a single implementation.
 
 func resourceAwsLexBot() *schema.Resource {
 	return &schema.Resource{
@@ -229,6 +50,12 @@ func resourceAwsLexBot() *schema.Resource {
 				MaxItems: 1,
 				Elem:     lexStatementResource,
 			},
+			"build_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "5m",
+				ValidateFunc: validateLexBuildTimeout,
+			},
 			"checksum": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -244,12 +71,31 @@ func resourceAwsLexBot() *schema.Resource {
 				MaxItems: 1,
 				Elem:     lexPromptResource,
 			},
+			"created_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "",
 				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
+			"detect_sentiment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_model_improvements": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"failure_reason": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -301,6 +147,11 @@ func resourceAwsLexBot() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), ""),
 				),
 			},
+			"nlu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
 			"process_behavior": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -314,6 +165,7 @@ func resourceAwsLexBot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags": lexTagsSchema(),
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -328,20 +180,81 @@ func resourceAwsLexBot() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
 
+// lexBotArn derives the ARN a Lex bot is tagged under: account ID + region + bot:{name}.
+func lexBotArn(meta interface{}, name string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", name),
+	}.String()
+}
+
+// validateLexBuildTimeout ensures build_timeout parses as a duration, since it is passed
+// straight to resource.StateChangeConf rather than through the Timeouts block.
+func validateLexBuildTimeout(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration: %s", k, err))
+	}
+
+	return
+}
+
+// waitLexBotReady polls GetBot until the bot leaves the BUILDING status, returning an error
+// that surfaces FailureReason if the build failed. Dependent resources (e.g. aws_lex_bot_alias)
+// would otherwise race an in-progress build.
+func waitLexBotReady(conn *lexmodelbuildingservice.LexModelBuildingService, name, version string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelbuildingservice.StatusBuilding},
+		Target: []string{
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusNotBuilt,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+				Name:           aws.String(name),
+				VersionOrAlias: aws.String(version),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			if aws.StringValue(resp.Status) == lexmodelbuildingservice.StatusFailed {
+				return resp, lexmodelbuildingservice.StatusFailed, fmt.Errorf("bot %s failed to build: %s", name, aws.StringValue(resp.FailureReason))
+			}
+
+			return resp, aws.StringValue(resp.Status), nil
+		},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lexmodelconn
 	name := d.Get("name").(string)
 
 	input := &lexmodelbuildingservice.PutBotInput{
-		AbortStatement:          expandLexStatement(d.Get("abort_statement")),
+		AbortStatement:          expandLexStatement(expandLexObject(d.Get("abort_statement"))),
 		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
-		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt")),
+		ClarificationPrompt:     expandLexPrompt(expandLexObject(d.Get("clarification_prompt"))),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		DetectSentiment:         aws.Bool(d.Get("detect_sentiment").(bool)),
 		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
-		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set).List()),
+		Intents:                 expandLexIntents(expandLexSet(d.Get("intent").(*schema.Set))),
 		Locale:                  aws.String(d.Get("locale").(string)),
 		Name:                    aws.String(name),
 		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
@@ -351,16 +264,50 @@ func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOkExists("enable_model_improvements"); ok {
+		input.EnableModelImprovements = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("nlu_intent_confidence_threshold"); ok {
+		input.NluIntentConfidenceThreshold = aws.Float64(v.(float64))
+	}
+
 	if v, ok := d.GetOk("voice_id"); ok {
 		input.VoiceId = aws.String(v.(string))
 	}
 
-	if _, err := conn.PutBot(input); err != nil {
+	resp, err := conn.PutBot(input)
+	if err != nil {
 		return fmt.Errorf("error creating bot %s: %s", name, err)
 	}
 
 	d.SetId(name)
 
+	if d.Get("process_behavior").(string) == lexmodelbuildingservice.ProcessBehaviorBuild && d.Get("wait_for_ready").(bool) {
+		buildTimeout, _ := time.ParseDuration(d.Get("build_timeout").(string))
+		if err := waitLexBotReady(conn, name, lexVersionLatest, buildTimeout); err != nil {
+			return fmt.Errorf("error waiting for bot %s to build: %s", name, err)
+		}
+	}
+
+	if aws.BoolValue(resp.CreateVersion) {
+		versionResp, err := conn.CreateBotVersion(&lexmodelbuildingservice.CreateBotVersionInput{
+			Checksum: resp.Checksum,
+			Name:     aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("error creating bot version for %s: %s", name, err)
+		}
+
+		d.Set("created_version", versionResp.Version)
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := setLexTags(conn, lexBotArn(meta, name), nil, v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error tagging bot %s: %s", name, err)
+		}
+	}
+
 	return resourceAwsLexBotRead(d, meta)
 }
 
@@ -387,16 +334,19 @@ func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
 		processBehavior = v.(string)
 	}
 
-	d.Set("abort_statement", flattenLexStatement(resp.AbortStatement))
+	d.Set("abort_statement", flattenLexObject(flattenLexStatement(resp.AbortStatement)))
 	d.Set("checksum", resp.Checksum)
 	d.Set("child_directed", resp.ChildDirected)
-	d.Set("clarification_prompt", flattenLexPrompt(resp.ClarificationPrompt))
+	d.Set("clarification_prompt", flattenLexObject(flattenLexPrompt(resp.ClarificationPrompt)))
 	d.Set("description", resp.Description)
+	d.Set("detect_sentiment", resp.DetectSentiment)
+	d.Set("enable_model_improvements", resp.EnableModelImprovements)
 	d.Set("failure_reason", resp.FailureReason)
 	d.Set("idle_session_ttl_in_seconds", resp.IdleSessionTTLInSeconds)
 	d.Set("intent", flattenLexIntents(resp.Intents))
 	d.Set("locale", resp.Locale)
 	d.Set("name", resp.Name)
+	d.Set("nlu_intent_confidence_threshold", resp.NluIntentConfidenceThreshold)
 	d.Set("process_behavior", processBehavior)
 	d.Set("status", resp.Status)
 	d.Set("version", resp.Version)
@@ -405,6 +355,14 @@ func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("voice_id", resp.VoiceId)
 	}
 
+	tagsResp, err := conn.ListTagsForResource(&lexmodelbuildingservice.ListTagsForResourceInput{
+		ResourceArn: aws.String(lexBotArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for bot %s: %s", d.Id(), err)
+	}
+	d.Set("tags", flattenLexTags(tagsResp.Tags))
+
 	return nil
 }
 
@@ -412,12 +370,14 @@ func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lexmodelconn
 
 	input := &lexmodelbuildingservice.PutBotInput{
-		AbortStatement:          expandLexStatement(d.Get("abort_statement")),
+		AbortStatement:          expandLexStatement(expandLexObject(d.Get("abort_statement"))),
 		Checksum:                aws.String(d.Get("checksum").(string)),
 		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
-		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt")),
+		ClarificationPrompt:     expandLexPrompt(expandLexObject(d.Get("clarification_prompt"))),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		DetectSentiment:         aws.Bool(d.Get("detect_sentiment").(bool)),
 		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
-		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set).List()),
+		Intents:                 expandLexIntents(expandLexSet(d.Get("intent").(*schema.Set))),
 		Locale:                  aws.String(d.Get("locale").(string)),
 		Name:                    aws.String(d.Id()),
 		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
@@ -427,12 +387,22 @@ func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOkExists("enable_model_improvements"); ok {
+		input.EnableModelImprovements = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("nlu_intent_confidence_threshold"); ok {
+		input.NluIntentConfidenceThreshold = aws.Float64(v.(float64))
+	}
+
 	if v, ok := d.GetOk("voice_id"); ok {
 		input.VoiceId = aws.String(v.(string))
 	}
 
+	var resp *lexmodelbuildingservice.PutBotOutput
 	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		_, err := conn.PutBot(input)
+		var err error
+		resp, err = conn.PutBot(input)
 
 		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
 			return resource.RetryableError(fmt.Errorf("%q: bot still updating", d.Id()))
@@ -447,6 +417,46 @@ func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error updating bot %s: %s", d.Id(), err)
 	}
 
+	if d.Get("process_behavior").(string) == lexmodelbuildingservice.ProcessBehaviorBuild && d.Get("wait_for_ready").(bool) {
+		buildTimeout, _ := time.ParseDuration(d.Get("build_timeout").(string))
+		if err := waitLexBotReady(conn, d.Id(), lexVersionLatest, buildTimeout); err != nil {
+			return fmt.Errorf("error waiting for bot %s to build: %s", d.Id(), err)
+		}
+	}
+
+	if aws.BoolValue(resp.CreateVersion) {
+		versionResp, err := conn.CreateBotVersion(&lexmodelbuildingservice.CreateBotVersionInput{
+			Checksum: resp.Checksum,
+			Name:     aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error creating bot version for %s: %s", d.Id(), err)
+		}
+
+		d.Set("created_version", versionResp.Version)
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		botArn := lexBotArn(meta, d.Id())
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexTags(conn, botArn, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: bot tags still updating", d.Id()))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for bot %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsLexBotRead(d, meta)
 }
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -29,6 +30,10 @@ func resourceAwsLexBotAlias() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		// Validate conversation_logs at plan time rather than waiting for PutBotAlias to reject it
+		// at apply time.
+		CustomizeDiff: resourceAwsLexBotAliasCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bot_name": {
 				Type:     schema.TypeString,
@@ -51,6 +56,12 @@ func resourceAwsLexBotAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"conversation_logs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexConversationLogsResource,
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -66,10 +77,36 @@ func resourceAwsLexBotAlias() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), ""),
 				),
 			},
+			"tags": lexTagsSchema(),
 		},
 	}
 }
 
+// resourceAwsLexBotAliasCustomizeDiff validates conversation_logs during terraform plan, so an
+// invalid kms_key_arn/destination/log_type combination fails fast instead of surfacing only when
+// PutBotAlias rejects it at apply time.
+func resourceAwsLexBotAliasCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("conversation_logs")
+	if !ok {
+		return nil
+	}
+
+	conversationLogs := expandLexObject(v.([]interface{}))
+	return validateLexConversationLogsLogSettings(expandLexSet(conversationLogs["log_settings"].(*schema.Set)))
+}
+
+// lexBotAliasArn derives the ARN a Lex bot alias is tagged under: account ID + region +
+// bot:{botName}:{aliasName}.
+func lexBotAliasArn(meta interface{}, botName, aliasName string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s:%s", botName, aliasName),
+	}.String()
+}
+
 func resourceAwsLexBotAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lexmodelconn
 	name := d.Get("name").(string)
@@ -84,12 +121,23 @@ func resourceAwsLexBotAliasCreate(d *schema.ResourceData, meta interface{}) erro
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("conversation_logs"); ok {
+		input.ConversationLogs = expandLexConversationLogs(expandLexObject(v.([]interface{})))
+	}
+
 	if _, err := conn.PutBotAlias(input); err != nil {
 		return fmt.Errorf("error creating bot alias %s: %s", name, err)
 	}
 
 	d.SetId(name)
 
+	if v, ok := d.GetOk("tags"); ok {
+		arn := lexBotAliasArn(meta, d.Get("bot_name").(string), name)
+		if err := setLexTags(conn, arn, nil, v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error tagging bot alias %s: %s", name, err)
+		}
+	}
+
 	return resourceAwsLexBotAliasRead(d, meta)
 }
 
@@ -112,9 +160,18 @@ func resourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("bot_name", resp.BotName)
 	d.Set("bot_version", resp.BotVersion)
 	d.Set("checksum", resp.Checksum)
+	d.Set("conversation_logs", flattenLexConversationLogs(resp.ConversationLogs))
 	d.Set("description", resp.Description)
 	d.Set("name", resp.Name)
 
+	tagsResp, err := conn.ListTagsForResource(&lexmodelbuildingservice.ListTagsForResourceInput{
+		ResourceArn: aws.String(lexBotAliasArn(meta, aws.StringValue(resp.BotName), d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for bot alias %s: %s", d.Id(), err)
+	}
+	d.Set("tags", flattenLexTags(tagsResp.Tags))
+
 	return nil
 }
 
@@ -132,6 +189,10 @@ func resourceAwsLexBotAliasUpdate(d *schema.ResourceData, meta interface{}) erro
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("conversation_logs"); ok {
+		input.ConversationLogs = expandLexConversationLogs(expandLexObject(v.([]interface{})))
+	}
+
 	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		_, err := conn.PutBotAlias(input)
 
@@ -148,6 +209,27 @@ func resourceAwsLexBotAliasUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error updating bot alias %s: %s", d.Id(), err)
 	}
 
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		arn := lexBotAliasArn(meta, d.Get("bot_name").(string), d.Id())
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexTags(conn, arn, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: bot alias tags still updating", d.Id()))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for bot alias %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsLexBotAliasRead(d, meta)
 }
 
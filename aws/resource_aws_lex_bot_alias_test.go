@@ -0,0 +1,18 @@
+package aws
+
+import "testing"
+
+func TestLexBotAliasArn(t *testing.T) {
+	meta := &AWSClient{
+		partition: "aws",
+		region:    "us-west-2",
+		accountid: "123456789012",
+	}
+
+	got := lexBotAliasArn(meta, "TestBot", "TestAlias")
+	want := "arn:aws:lex:us-west-2:123456789012:bot:TestBot:TestAlias"
+
+	if got != want {
+		t.Errorf("lexBotAliasArn() = %s, want %s", got, want)
+	}
+}
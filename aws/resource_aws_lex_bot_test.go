@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidateLexBuildTimeout(t *testing.T) {
+	if _, errors := validateLexBuildTimeout("5m", "build_timeout"); len(errors) != 0 {
+		t.Errorf("expected no errors for a valid duration, got %v", errors)
+	}
+
+	if _, errors := validateLexBuildTimeout("not-a-duration", "build_timeout"); len(errors) == 0 {
+		t.Error("expected an error for an invalid duration, got none")
+	}
+}
+
+// TestResourceAwsLexBotNluIntentConfidenceThresholdZeroValue checks that, for a configured
+// nlu_intent_confidence_threshold of 0, d.GetOk reports the field as unset while d.GetOkExists
+// reports it as set. This is why Create and Update read the field with GetOkExists.
+func TestResourceAwsLexBotNluIntentConfidenceThresholdZeroValue(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsLexBot().Schema, map[string]interface{}{
+		"nlu_intent_confidence_threshold": float64(0),
+	})
+
+	if _, ok := d.GetOk("nlu_intent_confidence_threshold"); ok {
+		t.Error("GetOk unexpectedly reports nlu_intent_confidence_threshold as set at its zero value")
+	}
+
+	if _, ok := d.GetOkExists("nlu_intent_confidence_threshold"); !ok {
+		t.Error("GetOkExists should report nlu_intent_confidence_threshold as set even at its zero value")
+	}
+}
@@ -0,0 +1,609 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexIntentArn derives the ARN a Lex intent is tagged under: account ID + region + intent:{name}.
+func lexIntentArn(meta interface{}, name string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("intent:%s", name),
+	}.String()
+}
+
+var lexSlotResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(lexDescriptionMinLength, lexDescriptionMaxLength),
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(lexNameMinLength, lexNameMaxLength),
+				validation.StringMatch(regexp.MustCompile(lexNameRegex), ""),
+			),
+		},
+		"priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"response_card": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(lexResponseCardMinLength, lexResponseCardMaxLength),
+		},
+		"sample_utterances": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+		},
+		"slot_constraint": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.SlotConstraintRequired,
+				lexmodelbuildingservice.SlotConstraintOptional,
+			}, false),
+		},
+		"slot_type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(lexNameMinLength, lexNameMaxLength),
+				validation.StringMatch(regexp.MustCompile(`^((AMAZON\.)_?|[A-Za-z]_?)+$`), ""),
+			),
+		},
+		"slot_type_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(lexVersionMinLength, lexVersionMaxLength),
+				validation.StringMatch(regexp.MustCompile(lexVersionRegex), ""),
+			),
+		},
+		"value_elicitation_prompt": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     lexPromptResource,
+		},
+	},
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) (flattenedSlots []map[string]interface{}) {
+	for _, slot := range slots {
+		flattenedSlot := map[string]interface{}{
+			"name":            aws.StringValue(slot.Name),
+			"priority":        aws.Int64Value(slot.Priority),
+			"slot_constraint": aws.StringValue(slot.SlotConstraint),
+			"slot_type":       aws.StringValue(slot.SlotType),
+		}
+
+		if slot.Description != nil {
+			flattenedSlot["description"] = aws.StringValue(slot.Description)
+		}
+		if slot.ResponseCard != nil {
+			flattenedSlot["response_card"] = aws.StringValue(slot.ResponseCard)
+		}
+		if slot.SampleUtterances != nil {
+			flattenedSlot["sample_utterances"] = aws.StringValueSlice(slot.SampleUtterances)
+		}
+		if slot.SlotTypeVersion != nil {
+			flattenedSlot["slot_type_version"] = aws.StringValue(slot.SlotTypeVersion)
+		}
+		if slot.ValueElicitationPrompt != nil {
+			flattenedSlot["value_elicitation_prompt"] = flattenLexObject(flattenLexPrompt(slot.ValueElicitationPrompt))
+		}
+
+		flattenedSlots = append(flattenedSlots, flattenedSlot)
+	}
+
+	return
+}
+
+func expandLexSlots(rawValues []map[string]interface{}) (slots []*lexmodelbuildingservice.Slot) {
+	for _, rawValue := range rawValues {
+		slot := &lexmodelbuildingservice.Slot{
+			Name:           aws.String(rawValue["name"].(string)),
+			SlotConstraint: aws.String(rawValue["slot_constraint"].(string)),
+			SlotType:       aws.String(rawValue["slot_type"].(string)),
+		}
+
+		if v, ok := rawValue["description"]; ok && v != "" {
+			slot.Description = aws.String(v.(string))
+		}
+		if v, ok := rawValue["priority"]; ok {
+			slot.Priority = aws.Int64(int64(v.(int)))
+		}
+		if v, ok := rawValue["response_card"]; ok && v != "" {
+			slot.ResponseCard = aws.String(v.(string))
+		}
+		if v, ok := rawValue["sample_utterances"]; ok {
+			for _, utterance := range v.([]interface{}) {
+				slot.SampleUtterances = append(slot.SampleUtterances, aws.String(utterance.(string)))
+			}
+		}
+		if v, ok := rawValue["slot_type_version"]; ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v.(string))
+		}
+		if v, ok := rawValue["value_elicitation_prompt"]; ok && len(v.([]interface{})) > 0 {
+			slot.ValueElicitationPrompt = expandLexPrompt(expandLexObject(v))
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return
+}
+
+var lexCodeHookResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message_version": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 5),
+		},
+		"uri": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+	},
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []map[string]interface{} {
+	if codeHook == nil {
+		return []map[string]interface{}{}
+	}
+
+	return flattenLexObject(map[string]interface{}{
+		"message_version": aws.StringValue(codeHook.MessageVersion),
+		"uri":             aws.StringValue(codeHook.Uri),
+	})
+}
+
+func expandLexCodeHook(m map[string]interface{}) *lexmodelbuildingservice.CodeHook {
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+var lexFulfillmentActivityResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"code_hook": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     lexCodeHookResource,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+				lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+			}, false),
+		},
+	},
+}
+
+func flattenLexFulfillmentActivity(activity *lexmodelbuildingservice.FulfillmentActivity) (flattened map[string]interface{}) {
+	flattened = map[string]interface{}{}
+	flattened["type"] = aws.StringValue(activity.Type)
+
+	if activity.CodeHook != nil {
+		flattened["code_hook"] = flattenLexCodeHook(activity.CodeHook)
+	}
+
+	return
+}
+
+func expandLexFulfillmentActivity(m map[string]interface{}) (activity *lexmodelbuildingservice.FulfillmentActivity) {
+	activity = &lexmodelbuildingservice.FulfillmentActivity{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["code_hook"]; ok && len(v.([]interface{})) > 0 {
+		activity.CodeHook = expandLexCodeHook(expandLexObject(v))
+	}
+
+	return
+}
+
+var lexFollowUpPromptResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"prompt": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem:     lexPromptResource,
+		},
+		"rejection_statement": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem:     lexStatementResource,
+		},
+	},
+}
+
+func flattenLexFollowUpPrompt(followUpPrompt *lexmodelbuildingservice.FollowUpPrompt) (flattened map[string]interface{}) {
+	flattened = map[string]interface{}{}
+	flattened["prompt"] = flattenLexObject(flattenLexPrompt(followUpPrompt.Prompt))
+	flattened["rejection_statement"] = flattenLexObject(flattenLexStatement(followUpPrompt.RejectionStatement))
+
+	return
+}
+
+func expandLexFollowUpPrompt(m map[string]interface{}) (followUpPrompt *lexmodelbuildingservice.FollowUpPrompt) {
+	followUpPrompt = &lexmodelbuildingservice.FollowUpPrompt{
+		Prompt:             expandLexPrompt(expandLexObject(m["prompt"])),
+		RejectionStatement: expandLexStatement(expandLexObject(m["rejection_statement"])),
+	}
+
+	return
+}
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("version", lexVersionLatest)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"confirmation_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexDescriptionDefault,
+				ValidateFunc: validation.StringLenBetween(lexDescriptionMinLength, lexDescriptionMaxLength),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexCodeHookResource,
+			},
+			"follow_up_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexFollowUpPromptResource,
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     lexFulfillmentActivityResource,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(lexNameMinLength, lexNameMaxLength),
+					validation.StringMatch(regexp.MustCompile(lexNameRegex), ""),
+				),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     lexSlotResource,
+			},
+			"tags": lexTagsSchema(),
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexVersionDefault,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(lexVersionMinLength, lexVersionMaxLength),
+					validation.StringMatch(regexp.MustCompile(lexVersionRegex), ""),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutIntentInput{
+		Description:         aws.String(d.Get("description").(string)),
+		FulfillmentActivity: expandLexFulfillmentActivity(expandLexObject(d.Get("fulfillment_activity"))),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("conclusion_statement"); ok {
+		input.ConclusionStatement = expandLexStatement(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("confirmation_prompt"); ok {
+		input.ConfirmationPrompt = expandLexPrompt(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("dialog_code_hook"); ok {
+		input.DialogCodeHook = expandLexCodeHook(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("follow_up_prompt"); ok {
+		input.FollowUpPrompt = expandLexFollowUpPrompt(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("rejection_statement"); ok {
+		input.RejectionStatement = expandLexStatement(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("sample_utterances"); ok {
+		for _, utterance := range v.([]interface{}) {
+			input.SampleUtterances = append(input.SampleUtterances, aws.String(utterance.(string)))
+		}
+	}
+	if v, ok := d.GetOk("slot"); ok {
+		slots := make([]map[string]interface{}, 0)
+		for _, rawSlot := range v.([]interface{}) {
+			slots = append(slots, rawSlot.(map[string]interface{}))
+		}
+		input.Slots = expandLexSlots(slots)
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: intent still updating", name))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating intent %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := setLexTags(conn, lexIntentArn(meta, name), nil, v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error tagging intent %s: %s", name, err)
+		}
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(d.Get("version").(string)),
+	})
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting intent %s: %s", d.Id(), err)
+	}
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("description", resp.Description)
+	d.Set("fulfillment_activity", flattenLexObject(flattenLexFulfillmentActivity(resp.FulfillmentActivity)))
+	d.Set("name", resp.Name)
+	d.Set("parent_intent_signature", resp.ParentIntentSignature)
+	d.Set("version", resp.Version)
+
+	if resp.ConclusionStatement != nil {
+		d.Set("conclusion_statement", flattenLexObject(flattenLexStatement(resp.ConclusionStatement)))
+	}
+	if resp.ConfirmationPrompt != nil {
+		d.Set("confirmation_prompt", flattenLexObject(flattenLexPrompt(resp.ConfirmationPrompt)))
+	}
+	if resp.DialogCodeHook != nil {
+		d.Set("dialog_code_hook", flattenLexCodeHook(resp.DialogCodeHook))
+	}
+	if resp.FollowUpPrompt != nil {
+		d.Set("follow_up_prompt", flattenLexObject(flattenLexFollowUpPrompt(resp.FollowUpPrompt)))
+	}
+	if resp.RejectionStatement != nil {
+		d.Set("rejection_statement", flattenLexObject(flattenLexStatement(resp.RejectionStatement)))
+	}
+	if resp.SampleUtterances != nil {
+		d.Set("sample_utterances", aws.StringValueSlice(resp.SampleUtterances))
+	}
+	if resp.Slots != nil {
+		d.Set("slot", flattenLexSlots(resp.Slots))
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&lexmodelbuildingservice.ListTagsForResourceInput{
+		ResourceArn: aws.String(lexIntentArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for intent %s: %s", d.Id(), err)
+	}
+	d.Set("tags", flattenLexTags(tagsResp.Tags))
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Id()
+
+	input := &lexmodelbuildingservice.PutIntentInput{
+		Checksum:            aws.String(d.Get("checksum").(string)),
+		Description:         aws.String(d.Get("description").(string)),
+		FulfillmentActivity: expandLexFulfillmentActivity(expandLexObject(d.Get("fulfillment_activity"))),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("conclusion_statement"); ok {
+		input.ConclusionStatement = expandLexStatement(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("confirmation_prompt"); ok {
+		input.ConfirmationPrompt = expandLexPrompt(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("dialog_code_hook"); ok {
+		input.DialogCodeHook = expandLexCodeHook(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("follow_up_prompt"); ok {
+		input.FollowUpPrompt = expandLexFollowUpPrompt(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("rejection_statement"); ok {
+		input.RejectionStatement = expandLexStatement(expandLexObject(v))
+	}
+	if v, ok := d.GetOk("sample_utterances"); ok {
+		for _, utterance := range v.([]interface{}) {
+			input.SampleUtterances = append(input.SampleUtterances, aws.String(utterance.(string)))
+		}
+	}
+	if v, ok := d.GetOk("slot"); ok {
+		slots := make([]map[string]interface{}, 0)
+		for _, rawSlot := range v.([]interface{}) {
+			slots = append(slots, rawSlot.(map[string]interface{}))
+		}
+		input.Slots = expandLexSlots(slots)
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: intent still updating", name))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating intent %s: %s", name, err)
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		intentArn := lexIntentArn(meta, name)
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexTags(conn, intentArn, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: intent tags still updating", name))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for intent %s: %s", name, err)
+		}
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+			Name: aws.String(d.Id()),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: intent still deleting", d.Id()))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting intent %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
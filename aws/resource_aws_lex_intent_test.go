@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestExpandFlattenLexSlots(t *testing.T) {
+	raw := []map[string]interface{}{
+		{
+			"name":            "City",
+			"priority":        1,
+			"slot_constraint": "Required",
+			"slot_type":       "AMAZON.US_CITY",
+		},
+	}
+
+	slots := expandLexSlots(raw)
+	if len(slots) != 1 {
+		t.Fatalf("expected 1 slot, got %d", len(slots))
+	}
+	if aws.StringValue(slots[0].Name) != "City" {
+		t.Errorf("Name = %s, want City", aws.StringValue(slots[0].Name))
+	}
+
+	got := flattenLexSlots(slots)
+	want := []map[string]interface{}{
+		{
+			"name":            "City",
+			"priority":        int64(1),
+			"slot_constraint": "Required",
+			"slot_type":       "AMAZON.US_CITY",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenLexSlots() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexSlotTypeArn derives the ARN a Lex slot type is tagged under: account ID + region +
+// slottype:{name}.
+func lexSlotTypeArn(meta interface{}, name string) string {
+	return arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("slottype:%s", name),
+	}.String()
+}
+
+var lexEnumerationValueResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"synonyms": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 140),
+			},
+		},
+		"value": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 140),
+		},
+	},
+}
+
+func flattenLexEnumerationValues(values []*lexmodelbuildingservice.EnumerationValue) (flattenedValues []map[string]interface{}) {
+	for _, value := range values {
+		flattenedValues = append(flattenedValues, map[string]interface{}{
+			"synonyms": aws.StringValueSlice(value.Synonyms),
+			"value":    aws.StringValue(value.Value),
+		})
+	}
+
+	return
+}
+
+func expandLexEnumerationValues(rawValues []map[string]interface{}) (values []*lexmodelbuildingservice.EnumerationValue) {
+	for _, rawValue := range rawValues {
+		value := &lexmodelbuildingservice.EnumerationValue{
+			Value: aws.String(rawValue["value"].(string)),
+		}
+
+		if v, ok := rawValue["synonyms"]; ok {
+			for _, synonym := range v.([]interface{}) {
+				value.Synonyms = append(value.Synonyms, aws.String(synonym.(string)))
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	return
+}
+
+func resourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexSlotTypeCreate,
+		Read:   resourceAwsLexSlotTypeRead,
+		Update: resourceAwsLexSlotTypeUpdate,
+		Delete: resourceAwsLexSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("version", lexVersionLatest)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexDescriptionDefault,
+				ValidateFunc: validation.StringLenBetween(lexDescriptionMinLength, lexDescriptionMaxLength),
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10000,
+				Elem:     lexEnumerationValueResource,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(lexNameMinLength, lexNameMaxLength),
+					validation.StringMatch(regexp.MustCompile(lexNameRegex), ""),
+				),
+			},
+			"tags": lexTagsSchema(),
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+					lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution,
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexVersionDefault,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(lexVersionMinLength, lexVersionMaxLength),
+					validation.StringMatch(regexp.MustCompile(lexVersionRegex), ""),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsLexSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(expandLexSet(d.Get("enumeration_value").(*schema.Set))),
+		Name:                   aws.String(name),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: slot type still updating", name))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating slot type %s: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := setLexTags(conn, lexSlotTypeArn(meta, name), nil, v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error tagging slot type %s: %s", name, err)
+		}
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(d.Get("version").(string)),
+	})
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Slot type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting slot type %s: %s", d.Id(), err)
+	}
+
+	d.Set("checksum", resp.Checksum)
+	d.Set("description", resp.Description)
+	d.Set("enumeration_value", flattenLexEnumerationValues(resp.EnumerationValues))
+	d.Set("name", resp.Name)
+	d.Set("value_selection_strategy", resp.ValueSelectionStrategy)
+	d.Set("version", resp.Version)
+
+	tagsResp, err := conn.ListTagsForResource(&lexmodelbuildingservice.ListTagsForResourceInput{
+		ResourceArn: aws.String(lexSlotTypeArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for slot type %s: %s", d.Id(), err)
+	}
+	d.Set("tags", flattenLexTags(tagsResp.Tags))
+
+	return nil
+}
+
+func resourceAwsLexSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Id()
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Checksum:               aws.String(d.Get("checksum").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(expandLexSet(d.Get("enumeration_value").(*schema.Set))),
+		Name:                   aws.String(name),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: slot type still updating", name))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating slot type %s: %s", name, err)
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		slotTypeArn := lexSlotTypeArn(meta, name)
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexTags(conn, slotTypeArn, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: slot type tags still updating", name))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for slot type %s: %s", name, err)
+		}
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteSlotType(&lexmodelbuildingservice.DeleteSlotTypeInput{
+			Name: aws.String(d.Id()),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: slot type still deleting", d.Id()))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting slot type %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
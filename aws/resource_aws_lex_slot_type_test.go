@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFlattenLexEnumerationValues(t *testing.T) {
+	raw := []map[string]interface{}{
+		{
+			"value":    "round",
+			"synonyms": []interface{}{"circular", "spherical"},
+		},
+	}
+
+	values := expandLexEnumerationValues(raw)
+	if len(values) != 1 {
+		t.Fatalf("expected 1 enumeration value, got %d", len(values))
+	}
+
+	got := flattenLexEnumerationValues(values)
+	want := []map[string]interface{}{
+		{
+			"value":    "round",
+			"synonyms": []string{"circular", "spherical"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenLexEnumerationValues() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexV2Bot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2BotCreate,
+		Read:   resourceAwsLexV2BotRead,
+		Update: resourceAwsLexV2BotUpdate,
+		Delete: resourceAwsLexV2BotDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_tags": lexTagsSchema(),
+			"bot_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelsv2.BotTypeBot,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelsv2.BotTypeBot,
+					lexmodelsv2.BotTypeBotNetwork,
+				}, false),
+			},
+			"data_privacy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     lexV2DataPrivacyResource,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"test_bot_alias_tags": lexTagsSchema(),
+		},
+	}
+}
+
+func resourceAwsLexV2BotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	name := d.Get("name").(string)
+
+	input := &lexmodelsv2.CreateBotInput{
+		BotName:                 aws.String(name),
+		BotType:                 aws.String(d.Get("bot_type").(string)),
+		DataPrivacy:             expandLexV2DataPrivacy(expandLexObject(d.Get("data_privacy"))),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("bot_tags"); ok {
+		input.BotTags = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("test_bot_alias_tags"); ok {
+		input.TestBotAliasTags = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	resp, err := conn.CreateBot(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 bot %s: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(resp.BotId))
+
+	return resourceAwsLexV2BotRead(d, meta)
+}
+
+func resourceAwsLexV2BotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	resp, err := conn.DescribeBot(&lexmodelsv2.DescribeBotInput{
+		BotId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lex V2 bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Lex V2 bot %s: %s", d.Id(), err)
+	}
+
+	d.Set("bot_type", resp.BotType)
+	d.Set("data_privacy", flattenLexV2DataPrivacy(resp.DataPrivacy))
+	d.Set("description", resp.Description)
+	d.Set("idle_session_ttl_in_seconds", resp.IdleSessionTTLInSeconds)
+	d.Set("name", resp.BotName)
+	d.Set("role_arn", resp.RoleArn)
+
+	botTagsResp, err := conn.ListTagsForResource(&lexmodelsv2.ListTagsForResourceInput{
+		ResourceARN: aws.String(lexV2BotArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Lex V2 bot %s: %s", d.Id(), err)
+	}
+	d.Set("bot_tags", flattenLexV2Tags(botTagsResp.Tags))
+
+	testBotAliasTagsResp, err := conn.ListTagsForResource(&lexmodelsv2.ListTagsForResourceInput{
+		ResourceARN: aws.String(lexV2TestBotAliasArn(meta, d.Id())),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Lex V2 bot %s test bot alias: %s", d.Id(), err)
+	}
+	d.Set("test_bot_alias_tags", flattenLexV2Tags(testBotAliasTagsResp.Tags))
+
+	return nil
+}
+
+func resourceAwsLexV2BotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	input := &lexmodelsv2.UpdateBotInput{
+		BotId:                   aws.String(d.Id()),
+		BotName:                 aws.String(d.Get("name").(string)),
+		DataPrivacy:             expandLexV2DataPrivacy(expandLexObject(d.Get("data_privacy"))),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.UpdateBot(input)
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot still updating", d.Id()))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 bot %s: %s", d.Id(), err)
+	}
+
+	if d.HasChange("bot_tags") {
+		oldTags, newTags := d.GetChange("bot_tags")
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexV2Tags(conn, lexV2BotArn(meta, d.Id()), oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: bot tags still updating", d.Id()))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for Lex V2 bot %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("test_bot_alias_tags") {
+		oldTags, newTags := d.GetChange("test_bot_alias_tags")
+
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			err := setLexV2Tags(conn, lexV2TestBotAliasArn(meta, d.Id()), oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+			if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+				return resource.RetryableError(fmt.Errorf("%q: test bot alias tags still updating", d.Id()))
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating tags for Lex V2 bot %s test bot alias: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLexV2BotRead(d, meta)
+}
+
+func resourceAwsLexV2BotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(&lexmodelsv2.DeleteBotInput{
+			BotId: aws.String(d.Id()),
+		})
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot still deleting", d.Id()))
+		}
+		if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 bot %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexV2BotAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2BotAliasCreate,
+		Read:   resourceAwsLexV2BotAliasRead,
+		Update: resourceAwsLexV2BotAliasUpdate,
+		Delete: resourceAwsLexV2BotAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexV2BotAliasImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_alias_locale_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     lexV2BotAliasLocaleSettingsResource,
+			},
+			"bot_alias_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"conversation_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexV2ConversationLogSettingsResource,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2BotAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	name := d.Get("bot_alias_name").(string)
+
+	input := &lexmodelsv2.CreateBotAliasInput{
+		BotAliasName: aws.String(name),
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(d.Get("bot_version").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("bot_alias_locale_settings"); ok {
+		input.BotAliasLocaleSettings = expandLexV2BotAliasLocaleSettings(expandLexSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("conversation_log_settings"); ok {
+		input.ConversationLogSettings = expandLexV2ConversationLogSettings(expandLexObject(v.([]interface{})))
+	}
+
+	resp, err := conn.CreateBotAlias(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 bot alias %s for bot %s: %s", name, botID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", botID, aws.StringValue(resp.BotAliasId)))
+
+	return resourceAwsLexV2BotAliasRead(d, meta)
+}
+
+func resourceAwsLexV2BotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, aliasID, err := resourceAwsLexV2BotAliasParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeBotAlias(&lexmodelsv2.DescribeBotAliasInput{
+		BotId:      aws.String(botID),
+		BotAliasId: aws.String(aliasID),
+	})
+	if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lex V2 bot alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Lex V2 bot alias %s: %s", d.Id(), err)
+	}
+
+	d.Set("bot_alias_locale_settings", flattenLexV2BotAliasLocaleSettings(resp.BotAliasLocaleSettings))
+	d.Set("bot_alias_name", resp.BotAliasName)
+	d.Set("bot_id", resp.BotId)
+	d.Set("bot_version", resp.BotVersion)
+	d.Set("conversation_log_settings", flattenLexV2ConversationLogSettings(resp.ConversationLogSettings))
+	d.Set("description", resp.Description)
+
+	return nil
+}
+
+func resourceAwsLexV2BotAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, aliasID, err := resourceAwsLexV2BotAliasParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lexmodelsv2.UpdateBotAliasInput{
+		BotAliasId:   aws.String(aliasID),
+		BotAliasName: aws.String(d.Get("bot_alias_name").(string)),
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(d.Get("bot_version").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("bot_alias_locale_settings"); ok {
+		input.BotAliasLocaleSettings = expandLexV2BotAliasLocaleSettings(expandLexSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("conversation_log_settings"); ok {
+		input.ConversationLogSettings = expandLexV2ConversationLogSettings(expandLexObject(v.([]interface{})))
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.UpdateBotAlias(input)
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot alias still updating", d.Id()))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 bot alias %s: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexV2BotAliasRead(d, meta)
+}
+
+func resourceAwsLexV2BotAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID, aliasID, err := resourceAwsLexV2BotAliasParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBotAlias(&lexmodelsv2.DeleteBotAliasInput{
+			BotId:      aws.String(botID),
+			BotAliasId: aws.String(aliasID),
+		})
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot alias still deleting", d.Id()))
+		}
+		if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 bot alias %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2BotAliasImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := resourceAwsLexV2BotAliasParseId(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsLexV2BotAliasParseId(id string) (botID, aliasID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid Lex V2 Bot Alias import id %q, expected BOT_ID/ALIAS_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
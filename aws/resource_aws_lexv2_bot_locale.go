@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexV2BotLocale() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2BotLocaleCreate,
+		Read:   resourceAwsLexV2BotLocaleRead,
+		Update: resourceAwsLexV2BotLocaleUpdate,
+		Delete: resourceAwsLexV2BotLocaleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexV2BotLocaleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "DRAFT",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nlu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"voice_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexV2VoiceSettingsResource,
+			},
+			"wait_for_build": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2BotLocaleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+
+	input := &lexmodelsv2.CreateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok {
+		input.VoiceSettings = expandLexV2VoiceSettings(expandLexObject(v.([]interface{})))
+	}
+
+	if _, err := conn.CreateBotLocale(input); err != nil {
+		return fmt.Errorf("error creating Lex V2 bot locale %s/%s/%s: %s", botID, botVersion, localeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", botID, botVersion, localeID))
+
+	if _, err := conn.BuildBotLocale(&lexmodelsv2.BuildBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}); err != nil {
+		return fmt.Errorf("error building Lex V2 bot locale %s: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_build").(bool) {
+		if err := waitLexV2BotLocaleBuilt(conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lex V2 bot locale %s to build: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLexV2BotLocaleRead(d, meta)
+}
+
+func resourceAwsLexV2BotLocaleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+
+	resp, err := conn.DescribeBotLocale(&lexmodelsv2.DescribeBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	})
+	if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lex V2 bot locale (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Lex V2 bot locale %s: %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", resp.BotId)
+	d.Set("bot_version", resp.BotVersion)
+	d.Set("description", resp.Description)
+	d.Set("locale_id", resp.LocaleId)
+	d.Set("nlu_intent_confidence_threshold", resp.NluIntentConfidenceThreshold)
+	d.Set("voice_settings", flattenLexV2VoiceSettings(resp.VoiceSettings))
+
+	return nil
+}
+
+func resourceAwsLexV2BotLocaleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+
+	input := &lexmodelsv2.UpdateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok {
+		input.VoiceSettings = expandLexV2VoiceSettings(expandLexObject(v.([]interface{})))
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.UpdateBotLocale(input)
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot locale still updating", d.Id()))
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Lex V2 bot locale %s: %s", d.Id(), err)
+	}
+
+	if _, err := conn.BuildBotLocale(&lexmodelsv2.BuildBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}); err != nil {
+		return fmt.Errorf("error building Lex V2 bot locale %s: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_build").(bool) {
+		if err := waitLexV2BotLocaleBuilt(conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lex V2 bot locale %s to build: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLexV2BotLocaleRead(d, meta)
+}
+
+func resourceAwsLexV2BotLocaleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBotLocale(&lexmodelsv2.DeleteBotLocaleInput{
+			BotId:      aws.String(d.Get("bot_id").(string)),
+			BotVersion: aws.String(d.Get("bot_version").(string)),
+			LocaleId:   aws.String(d.Get("locale_id").(string)),
+		})
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot locale still deleting", d.Id()))
+		}
+		if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 bot locale %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2BotLocaleImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid Lex V2 Bot Locale import id, expected BOT_ID/LOCALE_ID")
+	}
+
+	d.Set("bot_id", parts[0])
+	d.Set("bot_version", "DRAFT")
+	d.Set("locale_id", parts[1])
+	d.SetId(fmt.Sprintf("%s/DRAFT/%s", parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,19 @@
+package aws
+
+import "testing"
+
+// TestResourceAwsLexV2BotSchema checks that the aws_lexv2_bot schema has bot_tags, bot_type and
+// test_bot_alias_tags, and has no separate type field.
+func TestResourceAwsLexV2BotSchema(t *testing.T) {
+	s := resourceAwsLexV2Bot().Schema
+
+	for _, key := range []string{"bot_tags", "bot_type", "test_bot_alias_tags"} {
+		if _, ok := s[key]; !ok {
+			t.Errorf("expected aws_lexv2_bot schema to include %q", key)
+		}
+	}
+
+	if _, ok := s["type"]; ok {
+		t.Error("aws_lexv2_bot schema should use bot_type, not a duplicate type field")
+	}
+}
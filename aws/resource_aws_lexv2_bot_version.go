@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLexV2BotVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexV2BotVersionCreate,
+		Read:   resourceAwsLexV2BotVersionRead,
+		Delete: resourceAwsLexV2BotVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexV2BotVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"locale_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"source_bot_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexV2BotVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+	botID := d.Get("bot_id").(string)
+
+	localeSpec := make(map[string]*lexmodelsv2.BotVersionLocaleDetails)
+	for _, rawValue := range expandLexSet(d.Get("locale_specification").(*schema.Set)) {
+		localeSpec[rawValue["locale_id"].(string)] = &lexmodelsv2.BotVersionLocaleDetails{
+			SourceBotVersion: aws.String(rawValue["source_bot_version"].(string)),
+		}
+	}
+
+	input := &lexmodelsv2.CreateBotVersionInput{
+		BotId:                         aws.String(botID),
+		BotVersionLocaleSpecification: localeSpec,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	resp, err := conn.CreateBotVersion(input)
+	if err != nil {
+		return fmt.Errorf("error creating Lex V2 bot version for bot %s: %s", botID, err)
+	}
+
+	version := aws.StringValue(resp.BotVersion)
+	d.SetId(fmt.Sprintf("%s/%s", botID, version))
+	d.Set("version", version)
+
+	return resourceAwsLexV2BotVersionRead(d, meta)
+}
+
+func resourceAwsLexV2BotVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	botID := d.Get("bot_id").(string)
+	version := d.Get("version").(string)
+
+	resp, err := conn.DescribeBotVersion(&lexmodelsv2.DescribeBotVersionInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(version),
+	})
+	if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lex V2 bot version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Lex V2 bot version %s: %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", resp.BotId)
+	d.Set("description", resp.Description)
+	d.Set("version", resp.BotVersion)
+
+	return nil
+}
+
+func resourceAwsLexV2BotVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelsv2conn
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBotVersion(&lexmodelsv2.DeleteBotVersionInput{
+			BotId:      aws.String(d.Get("bot_id").(string)),
+			BotVersion: aws.String(d.Get("version").(string)),
+		})
+
+		if isAWSErr(err, lexmodelsv2.ErrCodeConflictException, "") {
+			return resource.RetryableError(fmt.Errorf("%q: bot version still deleting", d.Id()))
+		}
+		if isAWSErr(err, lexmodelsv2.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Lex V2 bot version %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexV2BotVersionImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid Lex V2 Bot Version import id, expected BOT_ID/VERSION")
+	}
+
+	d.Set("bot_id", parts[0])
+	d.Set("version", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}